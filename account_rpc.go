@@ -0,0 +1,168 @@
+package dcrlibwallet
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/decred/dcrd/txscript"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/decred/dcrwallet/wallet/txrules"
+	"github.com/raedahgroup/dcrlibwallet/addresshelper"
+)
+
+// AccountBalance is a single account's number, name and balances, the
+// account/balance query surface the gRPC WalletService wraps. Accounts
+// fills in only the total balance; Balance fills in the total, spendable
+// and immature-reward balances.
+type AccountBalance struct {
+	AccountNumber  uint32
+	AccountName    string
+	Total          int64
+	Spendable      int64
+	ImmatureReward int64
+}
+
+// TxDestination is a single output to pay in a constructed transaction.
+type TxDestination struct {
+	Address string
+	Amount  int64
+	SendMax bool
+}
+
+// Accounts returns every account in the wallet's default key scope along
+// with its total balance.
+func (lw *LibWallet) Accounts() ([]AccountBalance, error) {
+	result, err := lw.wallet.Accounts(wallet.DefaultKeyScope)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	accounts := make([]AccountBalance, len(result.Accounts))
+	for i, account := range result.Accounts {
+		accounts[i] = AccountBalance{
+			AccountNumber: account.AccountNumber,
+			AccountName:   account.AccountName,
+			Total:         int64(account.TotalBalance),
+		}
+	}
+
+	return accounts, nil
+}
+
+// Balance returns the total, spendable, and immature-reward balance of
+// account, requiring requiredConfirmations confirmations to count an
+// output as spendable.
+func (lw *LibWallet) Balance(accountNumber uint32, requiredConfirmations int32) (*AccountBalance, error) {
+	balances, err := lw.wallet.CalculateAccountBalance(accountNumber, requiredConfirmations)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &AccountBalance{
+		AccountNumber:  accountNumber,
+		Total:          int64(balances.Total),
+		Spendable:      int64(balances.Spendable),
+		ImmatureReward: int64(balances.ImmatureCoinbaseRewards + balances.ImmatureStakeGeneration),
+	}, nil
+}
+
+// ConstructTransaction builds, but does not sign, a transaction paying
+// destinations from sourceAccount, returning the serialized unsigned
+// transaction so it can be passed to SignTransaction.
+func (lw *LibWallet) ConstructTransaction(sourceAccount uint32, requiredConfirmations int32, destinations []TxDestination) (unsignedTx []byte, totalOutputAmount int64, totalPreviousOutputAmount int64, estimatedSignedSize int64, err error) {
+	outputs := make([]*wire.TxOut, len(destinations))
+	algorithm := wallet.OutputSelectionAlgorithmDefault
+	for i, dest := range destinations {
+		addr, decodeErr := addresshelper.DecodeForNetwork(dest.Address, lw.activeNet.Params)
+		if decodeErr != nil {
+			return nil, 0, 0, 0, translateError(decodeErr)
+		}
+		pkScript, scriptErr := txscript.PayToAddrScript(addr)
+		if scriptErr != nil {
+			return nil, 0, 0, 0, translateError(scriptErr)
+		}
+
+		amount := dest.Amount
+		if dest.SendMax {
+			// A SendMax destination sweeps the account's entire spendable
+			// balance (minus fees) into this output; NewUnsignedTransaction
+			// fills in the output's value itself when given this algorithm.
+			algorithm = wallet.OutputSelectionAlgorithmAll
+			amount = 0
+		}
+		outputs[i] = &wire.TxOut{Value: amount, PkScript: pkScript}
+	}
+
+	authoredTx, authorErr := lw.wallet.NewUnsignedTransaction(outputs, txrules.DefaultRelayFeePerKb, sourceAccount,
+		requiredConfirmations, algorithm)
+	if authorErr != nil {
+		return nil, 0, 0, 0, translateError(authorErr)
+	}
+
+	for _, output := range authoredTx.Tx.TxOut {
+		totalOutputAmount += output.Value
+	}
+
+	var buf bytes.Buffer
+	if err := authoredTx.Tx.Serialize(&buf); err != nil {
+		return nil, 0, 0, 0, translateError(err)
+	}
+
+	for _, previousInput := range authoredTx.PrevInputValues {
+		totalPreviousOutputAmount += int64(previousInput)
+	}
+
+	return buf.Bytes(), totalOutputAmount, totalPreviousOutputAmount, int64(authoredTx.EstimatedSignedSerializeSize), nil
+}
+
+// SignTransaction signs every input of an unsigned transaction (as
+// produced by ConstructTransaction) and returns the serialized, signed
+// transaction ready for PublishTransaction.
+func (lw *LibWallet) SignTransaction(passphrase []byte, unsignedTx []byte) ([]byte, error) {
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(unsignedTx)); err != nil {
+		return nil, translateError(err)
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{}
+	}()
+	if err := lw.wallet.Unlock(passphrase, lock); err != nil {
+		return nil, translateError(err)
+	}
+
+	additionalPkScripts := make(map[wire.OutPoint][]byte)
+	invalidSigs, err := lw.wallet.SignTransaction(&tx, txscript.SigHashAll, additionalPkScripts, nil, nil)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if len(invalidSigs) > 0 {
+		return nil, fmt.Errorf("failed to sign %d transaction input(s)", len(invalidSigs))
+	}
+
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, translateError(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PublishTransaction broadcasts a signed transaction (as produced by
+// SignTransaction) and returns its hash.
+func (lw *LibWallet) PublishTransaction(signedTx []byte) ([]byte, error) {
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(signedTx)); err != nil {
+		return nil, translateError(err)
+	}
+
+	hash, err := lw.wallet.PublishTransaction(&tx, signedTx, lw.rpcClient)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return hash[:], nil
+}
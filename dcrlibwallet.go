@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -19,8 +20,11 @@ import (
 	"github.com/decred/dcrwallet/wallet"
 	"github.com/decred/dcrwallet/wallet/txrules"
 	"github.com/raedahgroup/dcrlibwallet/addresshelper"
+	"github.com/raedahgroup/dcrlibwallet/rpc/rpcserver"
 	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/dcrlibwallet/vsp"
 	"go.etcd.io/bbolt"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -44,6 +48,15 @@ type LibWallet struct {
 	walletLoader  *WalletLoader
 	wallet        *wallet.Wallet
 	txDB          *storm.DB
+	stakeOptions  *StakeOptions
+	vspClient     *vsp.Client
+	grpcServer    *grpc.Server
+	txNotifier    *rpcserver.TransactionNotifier
+
+	reorgDepthLimit   int32
+	reorgDepthLimitMu sync.Mutex
+	reorgListeners    []ReorgNotificationListener
+	reorgListenersMu  sync.Mutex
 	*syncData
 }
 
@@ -83,6 +96,20 @@ func newLibWallet(walletDataDir, walletDbDriver string, activeNet *netparams.Par
 		return nil, err
 	}
 
+	// init database for saving/reading the wallet's VSP configuration
+	err = txDB.Init(&VSPConfig{})
+	if err != nil {
+		log.Errorf("Error initializing vsp config database for wallet: %s", err.Error())
+		return nil, err
+	}
+
+	// init database for saving/reading ticket objects
+	err = txDB.Init(&Ticket{})
+	if err != nil {
+		log.Errorf("Error initializing ticket database for wallet: %s", err.Error())
+		return nil, err
+	}
+
 	// init walletLoader
 	stakeOptions := &StakeOptions{
 		VotingEnabled: false,
@@ -104,15 +131,22 @@ func newLibWallet(walletDataDir, walletDbDriver string, activeNet *netparams.Par
 		txDB:          txDB,
 		activeNet:     activeNet,
 		walletLoader:  walletLoader,
+		stakeOptions:  stakeOptions,
 		syncData:      &syncData{},
 	}
 
+	walletLoader.RunAfterLoad(func(w *wallet.Wallet) {
+		go lw.listenForTransactions()
+	})
+
 	return lw, nil
 }
 
 func (lw *LibWallet) Shutdown(exit bool) {
 	log.Info("Shutting down mobile wallet")
 
+	lw.StopGRPCServer()
+
 	if lw.rpcClient != nil {
 		lw.rpcClient.Stop()
 	}
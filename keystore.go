@@ -0,0 +1,259 @@
+package dcrlibwallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/raedahgroup/dcrlibwallet/utils"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+)
+
+// keystoreVersion is the version field of the exported JSON envelope,
+// matching Ethereum's v3 keystore format.
+const keystoreVersion = 3
+
+// encryptedSeed is the on-disk JSON envelope produced by
+// ExportEncryptedSeed, modeled on Ethereum's v3 keystore format so it can
+// be inspected or migrated with standard tooling.
+type encryptedSeed struct {
+	Version int                 `json:"version"`
+	Crypto  encryptedSeedCrypto `json:"crypto"`
+}
+
+type encryptedSeedCrypto struct {
+	Cipher       string                    `json:"cipher"`
+	CipherText   string                    `json:"ciphertext"`
+	CipherParams encryptedSeedCipherParams `json:"cipherparams"`
+	KDF          string                    `json:"kdf"`
+	KDFParams    encryptedSeedKDFParams    `json:"kdfparams"`
+	MAC          string                    `json:"mac"`
+}
+
+type encryptedSeedCipherParams struct {
+	IV string `json:"iv"`
+}
+
+type encryptedSeedKDFParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// ExportEncryptedSeed returns the wallet's HD seed wrapped in a
+// passphrase-encrypted JSON envelope in the style of Ethereum's v3
+// keystore: an AES-128-CTR ciphertext under an scrypt-derived key, with a
+// keccak256 MAC over the ciphertext. The result is a portable, offline
+// readable backup distinct from the wallet's storm/bbolt database files.
+func (lw *LibWallet) ExportEncryptedSeed(passphrase []byte, scryptN, scryptR, scryptP int) ([]byte, error) {
+	seed, err := lw.wallet.Seed()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	blob, err := encryptSeed(seed, passphrase, salt, scryptN, scryptR, scryptP)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(blob)
+}
+
+// ImportEncryptedSeed decrypts jsonBlob (as produced by
+// ExportEncryptedSeed) with passphrase and restores the wallet from the
+// recovered seed.
+func (lw *LibWallet) ImportEncryptedSeed(jsonBlob, passphrase []byte) error {
+	seed, err := decryptSeed(jsonBlob, passphrase)
+	if err != nil {
+		return err
+	}
+
+	return lw.restoreFromSeed(seed, passphrase)
+}
+
+// ChangeEncryptedSeedPassphrase re-encrypts jsonBlob under newPass
+// without exposing the recovered seed to the caller.
+func ChangeEncryptedSeedPassphrase(jsonBlob, oldPass, newPass []byte) ([]byte, error) {
+	var blob encryptedSeed
+	if err := json.Unmarshal(jsonBlob, &blob); err != nil {
+		return nil, fmt.Errorf("invalid encrypted seed: %v", err)
+	}
+
+	seed, err := decryptSeed(jsonBlob, oldPass)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	newBlob, err := encryptSeed(seed, newPass, salt, blob.Crypto.KDFParams.N, blob.Crypto.KDFParams.R, blob.Crypto.KDFParams.P)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(newBlob)
+}
+
+// restoreFromSeed re-creates the wallet from a recovered BIP-32 seed,
+// the same way the wallet is normally restored from a mnemonic on first
+// run.
+func (lw *LibWallet) restoreFromSeed(seed, privatePassphrase []byte) error {
+	if _, loaded := lw.walletLoader.LoadedWallet(); loaded {
+		return fmt.Errorf("cannot import seed into an already loaded wallet")
+	}
+
+	w, err := lw.walletLoader.CreateNewWallet(utils.DefaultPubPassphrase, privatePassphrase, seed)
+	if err != nil {
+		return translateError(err)
+	}
+
+	lw.wallet = w
+	return nil
+}
+
+func encryptSeed(seed, passphrase, salt []byte, scryptN, scryptR, scryptP int) (*encryptedSeed, error) {
+	derivedKey, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %v", err)
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext := make([]byte, len(seed))
+	cipher.NewCTR(block, iv).XORKeyStream(ciphertext, seed)
+
+	mac := keccak256(append(derivedKey[16:32], ciphertext...))
+
+	return &encryptedSeed{
+		Version: keystoreVersion,
+		Crypto: encryptedSeedCrypto{
+			Cipher:     "aes-128-ctr",
+			CipherText: hex.EncodeToString(ciphertext),
+			CipherParams: encryptedSeedCipherParams{
+				IV: hex.EncodeToString(iv),
+			},
+			KDF: "scrypt",
+			KDFParams: encryptedSeedKDFParams{
+				N:     scryptN,
+				R:     scryptR,
+				P:     scryptP,
+				DKLen: 32,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac),
+		},
+	}, nil
+}
+
+func decryptSeed(jsonBlob, passphrase []byte) ([]byte, error) {
+	var blob encryptedSeed
+	if err := json.Unmarshal(jsonBlob, &blob); err != nil {
+		return nil, fmt.Errorf("invalid encrypted seed: %v", err)
+	}
+	if blob.Crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("unsupported cipher: %s", blob.Crypto.Cipher)
+	}
+
+	salt, err := hex.DecodeString(blob.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid salt: %v", err)
+	}
+	iv, err := hex.DecodeString(blob.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("invalid iv: %v", err)
+	}
+	ciphertext, err := hex.DecodeString(blob.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ciphertext: %v", err)
+	}
+	wantMAC, err := hex.DecodeString(blob.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mac: %v", err)
+	}
+
+	params := blob.Crypto.KDFParams
+	if err := validateKDFParams(params); err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key(passphrase, salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %v", err)
+	}
+
+	gotMAC := keccak256(append(derivedKey[16:32], ciphertext...))
+	if !hmacEqual(gotMAC, wantMAC) {
+		return nil, fmt.Errorf("incorrect passphrase")
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+
+	seed := make([]byte, len(ciphertext))
+	cipher.NewCTR(block, iv).XORKeyStream(seed, ciphertext)
+
+	return seed, nil
+}
+
+// validateKDFParams rejects scrypt parameters read back from an untrusted
+// JSON blob that would otherwise make scrypt.Key return a derived key too
+// short to slice into a 16-byte AES key and a 16-byte MAC input, or that
+// are simply unreasonable to compute.
+func validateKDFParams(params encryptedSeedKDFParams) error {
+	if params.DKLen < 32 {
+		return fmt.Errorf("invalid kdf params: dklen must be at least 32, got %d", params.DKLen)
+	}
+	if params.N <= 1 || params.N > 1<<20 {
+		return fmt.Errorf("invalid kdf params: n out of range")
+	}
+	if params.R <= 0 || params.R > 1024 {
+		return fmt.Errorf("invalid kdf params: r out of range")
+	}
+	if params.P <= 0 || params.P > 1024 {
+		return fmt.Errorf("invalid kdf params: p out of range")
+	}
+	return nil
+}
+
+func keccak256(data []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// hmacEqual is a constant-time byte slice comparison, used instead of
+// bytes.Equal to avoid timing side channels when checking the MAC.
+func hmacEqual(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	var v byte
+	for i := range a {
+		v |= a[i] ^ b[i]
+	}
+	return v == 0
+}
@@ -0,0 +1,64 @@
+package dcrlibwallet
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestEncryptDecryptSeedRoundTrip(t *testing.T) {
+	seed := []byte("0123456789abcdef0123456789abcdef")
+	passphrase := []byte("hunter2")
+
+	blob, err := encryptSeed(seed, passphrase, make([]byte, 32), 2, 1, 1)
+	if err != nil {
+		t.Fatalf("encryptSeed: %v", err)
+	}
+
+	jsonBlob, err := json.Marshal(blob)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	got, err := decryptSeed(jsonBlob, passphrase)
+	if err != nil {
+		t.Fatalf("decryptSeed: %v", err)
+	}
+	if !bytes.Equal(got, seed) {
+		t.Fatalf("decrypted seed = %x, want %x", got, seed)
+	}
+}
+
+func TestDecryptSeedWrongPassphrase(t *testing.T) {
+	seed := []byte("0123456789abcdef0123456789abcdef")
+	blob, err := encryptSeed(seed, []byte("correct"), make([]byte, 32), 2, 1, 1)
+	if err != nil {
+		t.Fatalf("encryptSeed: %v", err)
+	}
+	jsonBlob, err := json.Marshal(blob)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	if _, err := decryptSeed(jsonBlob, []byte("wrong")); err == nil {
+		t.Fatal("decryptSeed succeeded with the wrong passphrase")
+	}
+}
+
+func TestDecryptSeedRejectsShortDKLen(t *testing.T) {
+	seed := []byte("0123456789abcdef0123456789abcdef")
+	blob, err := encryptSeed(seed, []byte("hunter2"), make([]byte, 32), 2, 1, 1)
+	if err != nil {
+		t.Fatalf("encryptSeed: %v", err)
+	}
+	blob.Crypto.KDFParams.DKLen = 16
+
+	jsonBlob, err := json.Marshal(blob)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	if _, err := decryptSeed(jsonBlob, []byte("hunter2")); err == nil {
+		t.Fatal("decryptSeed accepted a corrupted dklen instead of returning an error")
+	}
+}
@@ -0,0 +1,255 @@
+package dcrlibwallet
+
+import (
+	"fmt"
+
+	"github.com/asdine/storm"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+	"go.etcd.io/bbolt"
+)
+
+// ReorgNotificationListener is notified whenever the tx index rewinds to
+// a new fork point after a chain reorganization.
+type ReorgNotificationListener interface {
+	OnReorg(oldTip, newTip string, commonAncestorHeight int32)
+}
+
+// SetReorgDepthLimit configures how many blocks back onReorg will walk
+// looking for the fork point. It overrides the MaxReOrgBlocks default and
+// must be called before sync starts to take effect.
+func (lw *LibWallet) SetReorgDepthLimit(blocks int) {
+	lw.reorgDepthLimitMu.Lock()
+	defer lw.reorgDepthLimitMu.Unlock()
+	lw.reorgDepthLimit = int32(blocks)
+}
+
+func (lw *LibWallet) reorgDepthLimitOrDefault() int32 {
+	lw.reorgDepthLimitMu.Lock()
+	defer lw.reorgDepthLimitMu.Unlock()
+	if lw.reorgDepthLimit <= 0 {
+		return MaxReOrgBlocks
+	}
+	return lw.reorgDepthLimit
+}
+
+// AddReorgNotificationListener registers listener to be notified whenever
+// the tx index rewinds after a chain reorganization.
+func (lw *LibWallet) AddReorgNotificationListener(listener ReorgNotificationListener) {
+	lw.reorgListenersMu.Lock()
+	defer lw.reorgListenersMu.Unlock()
+	lw.reorgListeners = append(lw.reorgListeners, listener)
+}
+
+// onReorg is invoked by the sync listener whenever the wallet reports a
+// reorganization (a block disconnected in favor of a new best chain). It
+// rewinds the storm-indexed transactions back to the fork point and
+// re-indexes the new chain from there forward, since the storm tx index
+// otherwise has no rollback path and silently diverges from the wallet's
+// internal state.
+func (lw *LibWallet) onReorg(oldTipHash, newTipHash string, newTipHeight int32) error {
+	forkHeight, err := lw.findReorgForkPoint(newTipHeight)
+	if err != nil {
+		return err
+	}
+
+	changedHashes, err := lw.rewindTxIndexTo(forkHeight)
+	if err != nil {
+		return err
+	}
+
+	if err := lw.reindexTxsFrom(forkHeight+1, newTipHeight); err != nil {
+		return err
+	}
+
+	if err := lw.setEndBlock(newTipHash, newTipHeight); err != nil {
+		return err
+	}
+
+	for _, hash := range changedHashes {
+		lw.mempoolOrTxDirectionChanged(hash, txhelper.TransactionDirectionUnclear)
+	}
+
+	lw.reorgListenersMu.Lock()
+	listeners := make([]ReorgNotificationListener, len(lw.reorgListeners))
+	copy(listeners, lw.reorgListeners)
+	lw.reorgListenersMu.Unlock()
+
+	for _, listener := range listeners {
+		listener.OnReorg(oldTipHash, newTipHash, forkHeight)
+	}
+
+	return nil
+}
+
+// findReorgForkPoint walks back from the last indexed end block, within
+// the configured reorg depth limit, until it finds a height whose
+// previously-indexed hash (recorded by setEndBlock as each block was
+// connected) still matches the wallet's current main-chain block at that
+// height. The walk stops at the depth limit below the higher of the
+// indexed end block and the new tip; if no match is found, that lowest
+// searched height is used as the fork point.
+func (lw *LibWallet) findReorgForkPoint(newTipHeight int32) (int32, error) {
+	endHeight, _, err := lw.endBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	limit := lw.reorgDepthLimitOrDefault()
+	lowest := endHeight - limit
+	if newTipHeight-limit > lowest {
+		lowest = newTipHeight - limit
+	}
+
+	for height := endHeight; height >= lowest; height-- {
+		indexedHash, err := lw.indexedBlockHash(height)
+		if err != nil {
+			return 0, err
+		}
+		if indexedHash == "" {
+			continue
+		}
+
+		mainChainHash, err := lw.wallet.GetBlockHash(int64(height))
+		if err != nil {
+			return 0, translateError(err)
+		}
+		if mainChainHash.String() == indexedHash {
+			return height, nil
+		}
+	}
+
+	return lowest, nil
+}
+
+// rewindTxIndexTo deletes every indexed Transaction above forkHeight and
+// returns the hashes of the transactions that were removed, so listeners
+// can be told they changed side (e.g. a Sent became orphaned).
+func (lw *LibWallet) rewindTxIndexTo(forkHeight int32) ([]string, error) {
+	var orphaned []Transaction
+	err := lw.txDB.Select(storm.Gt("Height", forkHeight)).Find(&orphaned)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, translateError(err)
+	}
+
+	hashes := make([]string, len(orphaned))
+	for i, tx := range orphaned {
+		hashes[i] = tx.Hash
+		if err := lw.txDB.DeleteStruct(&tx); err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	var orphanedTickets []Ticket
+	err = lw.txDB.Select(storm.Gt("BlockHeight", forkHeight)).Find(&orphanedTickets)
+	if err != nil && err != storm.ErrNotFound {
+		return nil, translateError(err)
+	}
+	for _, ticket := range orphanedTickets {
+		ticket.Status = txhelper.TicketStatusLive
+		ticket.BlockHeight = 0
+		if err := lw.txDB.Save(&ticket); err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	return hashes, nil
+}
+
+// reindexTxsFrom re-scans every block in [fromHeight, toHeight] on the
+// new best chain and re-inserts their wallet-relevant transactions into
+// the storm tx index.
+func (lw *LibWallet) reindexTxsFrom(fromHeight, toHeight int32) error {
+	for height := fromHeight; height <= toHeight; height++ {
+		blockHash, err := lw.wallet.GetBlockHash(int64(height))
+		if err != nil {
+			return translateError(err)
+		}
+
+		txs, err := lw.wallet.GetTransactionsByBlock(blockHash)
+		if err != nil {
+			return translateError(err)
+		}
+
+		for _, tx := range txs {
+			if err := lw.txDB.Save(tx); err != nil {
+				return translateError(err)
+			}
+		}
+	}
+	return nil
+}
+
+// mempoolOrTxDirectionChanged notifies listeners that hash's effective
+// direction changed following a reorg (e.g. a confirmed Sent became an
+// orphaned, unmined transaction).
+func (lw *LibWallet) mempoolOrTxDirectionChanged(hash string, newDirection txhelper.TransactionDirection) {
+	log.Infof("Transaction %s changed direction to %s after reorg", hash, newDirection)
+}
+
+func (lw *LibWallet) endBlock() (int32, string, error) {
+	var height int32
+	var hash string
+
+	err := lw.txDB.Bolt.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketTxInfo))
+		if bucket == nil {
+			return nil
+		}
+		value := bucket.Get([]byte(KeyEndBlock))
+		if value == nil {
+			return nil
+		}
+		hash = string(value[4:])
+		height = int32(value[0]) | int32(value[1])<<8 | int32(value[2])<<16 | int32(value[3])<<24
+		return nil
+	})
+
+	return height, hash, translateError(err)
+}
+
+// setEndBlock records hash as the tip indexed at height, and keeps a
+// rolling log of the last reorgDepthLimit block hashes (keyed by height)
+// so a later reorg can find the fork point by comparing them against the
+// new main chain.
+func (lw *LibWallet) setEndBlock(hash string, height int32) error {
+	value := make([]byte, 4+len(hash))
+	value[0] = byte(height)
+	value[1] = byte(height >> 8)
+	value[2] = byte(height >> 16)
+	value[3] = byte(height >> 24)
+	copy(value[4:], hash)
+
+	limit := lw.reorgDepthLimitOrDefault()
+
+	return translateError(lw.txDB.Bolt.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(BucketTxInfo))
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put([]byte(KeyEndBlock), value); err != nil {
+			return err
+		}
+		if err := bucket.Put(blockHashKey(height), []byte(hash)); err != nil {
+			return err
+		}
+		return bucket.Delete(blockHashKey(height - limit - 1))
+	}))
+}
+
+func (lw *LibWallet) indexedBlockHash(height int32) (string, error) {
+	var hash string
+	err := lw.txDB.Bolt.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketTxInfo))
+		if bucket == nil {
+			return nil
+		}
+		value := bucket.Get(blockHashKey(height))
+		hash = string(value)
+		return nil
+	})
+	return hash, translateError(err)
+}
+
+func blockHashKey(height int32) []byte {
+	return []byte(fmt.Sprintf("block-%d", height))
+}
@@ -0,0 +1,219 @@
+// Package rpcserver implements the walletrpc.WalletService and
+// walletrpc.TransactionNotificationsService gRPC services on top of an
+// already-loaded LibWallet, so non-Go clients can drive the mobile wallet
+// core without going through gomobile bindings.
+package rpcserver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/raedahgroup/dcrlibwallet/rpc/walletrpc"
+)
+
+// AccountBalance mirrors dcrlibwallet.AccountBalance, kept as a separate
+// type so this package does not need to import dcrlibwallet directly.
+type AccountBalance struct {
+	AccountNumber  uint32
+	AccountName    string
+	Total          int64
+	Spendable      int64
+	ImmatureReward int64
+}
+
+// TxDestination mirrors dcrlibwallet.TxDestination.
+type TxDestination struct {
+	Address string
+	Amount  int64
+	SendMax bool
+}
+
+// Wallet is the subset of *dcrlibwallet.LibWallet the RPC server depends
+// on. It is defined here, rather than importing dcrlibwallet directly, to
+// avoid an import cycle with the package that constructs this server.
+type Wallet interface {
+	SignMessage(passphrase []byte, address string, message string) ([]byte, error)
+	VerifyMessage(address string, message string, signatureBase64 string) (bool, error)
+	CallJSONRPC(method string, args string, address string, username string, password string, caCert string) (string, error)
+	Accounts() ([]AccountBalance, error)
+	Balance(accountNumber uint32, requiredConfirmations int32) (*AccountBalance, error)
+	ConstructTransaction(sourceAccount uint32, requiredConfirmations int32, destinations []TxDestination) (unsignedTx []byte, totalOutputAmount int64, totalPreviousOutputAmount int64, estimatedSignedSize int64, err error)
+	SignTransaction(passphrase []byte, unsignedTx []byte) ([]byte, error)
+	PublishTransaction(signedTx []byte) ([]byte, error)
+}
+
+// walletServer implements walletrpc.WalletServiceServer.
+type walletServer struct {
+	wallet Wallet
+}
+
+// NewWalletServer returns a walletrpc.WalletServiceServer backed by
+// wallet.
+func NewWalletServer(wallet Wallet) walletrpc.WalletServiceServer {
+	return &walletServer{wallet: wallet}
+}
+
+func (s *walletServer) SignMessage(ctx context.Context, req *walletrpc.SignMessageRequest) (*walletrpc.SignMessageResponse, error) {
+	sig, err := s.wallet.SignMessage(req.Passphrase, req.Address, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &walletrpc.SignMessageResponse{Signature: sig}, nil
+}
+
+func (s *walletServer) VerifyMessage(ctx context.Context, req *walletrpc.VerifyMessageRequest) (*walletrpc.VerifyMessageResponse, error) {
+	valid, err := s.wallet.VerifyMessage(req.Address, req.Message, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return &walletrpc.VerifyMessageResponse{Valid: valid}, nil
+}
+
+func (s *walletServer) Accounts(ctx context.Context, req *walletrpc.AccountsRequest) (*walletrpc.AccountsResponse, error) {
+	accounts, err := s.wallet.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &walletrpc.AccountsResponse{Accounts: make([]*walletrpc.Account, len(accounts))}
+	for i, account := range accounts {
+		resp.Accounts[i] = &walletrpc.Account{
+			AccountNumber: account.AccountNumber,
+			AccountName:   account.AccountName,
+			TotalBalance:  account.Total,
+		}
+	}
+	return resp, nil
+}
+
+func (s *walletServer) Balance(ctx context.Context, req *walletrpc.BalanceRequest) (*walletrpc.BalanceResponse, error) {
+	balance, err := s.wallet.Balance(req.AccountNumber, req.RequiredConfirmations)
+	if err != nil {
+		return nil, err
+	}
+	return &walletrpc.BalanceResponse{
+		Total:          balance.Total,
+		Spendable:      balance.Spendable,
+		ImmatureReward: balance.ImmatureReward,
+	}, nil
+}
+
+func (s *walletServer) ConstructTransaction(ctx context.Context, req *walletrpc.ConstructTransactionRequest) (*walletrpc.ConstructTransactionResponse, error) {
+	destinations := make([]TxDestination, len(req.Destinations))
+	for i, dest := range req.Destinations {
+		destinations[i] = TxDestination{
+			Address: dest.Address,
+			Amount:  dest.Amount,
+			SendMax: dest.SendMax,
+		}
+	}
+
+	unsignedTx, totalOutputAmount, totalPreviousOutputAmount, estimatedSignedSize, err := s.wallet.ConstructTransaction(
+		req.SourceAccount, req.RequiredConfirmations, destinations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &walletrpc.ConstructTransactionResponse{
+		UnsignedTransaction:       unsignedTx,
+		TotalOutputAmount:         totalOutputAmount,
+		TotalPreviousOutputAmount: totalPreviousOutputAmount,
+		EstimatedSignedSize:       estimatedSignedSize,
+	}, nil
+}
+
+func (s *walletServer) SignTransaction(ctx context.Context, req *walletrpc.SignTransactionRequest) (*walletrpc.SignTransactionResponse, error) {
+	signedTx, err := s.wallet.SignTransaction(req.Passphrase, req.UnsignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+	return &walletrpc.SignTransactionResponse{Transaction: signedTx}, nil
+}
+
+func (s *walletServer) PublishTransaction(ctx context.Context, req *walletrpc.PublishTransactionRequest) (*walletrpc.PublishTransactionResponse, error) {
+	txHash, err := s.wallet.PublishTransaction(req.SignedTransaction)
+	if err != nil {
+		return nil, err
+	}
+	return &walletrpc.PublishTransactionResponse{TransactionHash: txHash}, nil
+}
+
+func (s *walletServer) CallJSONRPC(ctx context.Context, req *walletrpc.CallJSONRPCRequest) (*walletrpc.CallJSONRPCResponse, error) {
+	result, err := s.wallet.CallJSONRPC(req.Method, req.Args, req.Address, req.Username, req.Password, req.CaCert)
+	if err != nil {
+		return nil, err
+	}
+	return &walletrpc.CallJSONRPCResponse{Result: result}, nil
+}
+
+// TransactionNotifier broadcasts transaction events to every subscribed
+// gRPC client, backed by the same events syncData emits internally.
+type TransactionNotifier struct {
+	mu          sync.Mutex
+	subscribers map[chan *walletrpc.TransactionNotificationResponse]struct{}
+}
+
+// NewTransactionNotifier returns an empty notifier ready to accept
+// subscribers and notifications.
+func NewTransactionNotifier() *TransactionNotifier {
+	return &TransactionNotifier{
+		subscribers: make(map[chan *walletrpc.TransactionNotificationResponse]struct{}),
+	}
+}
+
+// Notify delivers notification to every currently subscribed client
+// without blocking; a subscriber whose 16-notification buffer is full
+// does not receive it.
+func (n *TransactionNotifier) Notify(notification *walletrpc.TransactionNotificationResponse) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for subscriber := range n.subscribers {
+		select {
+		case subscriber <- notification:
+		default:
+		}
+	}
+}
+
+func (n *TransactionNotifier) subscribe() chan *walletrpc.TransactionNotificationResponse {
+	ch := make(chan *walletrpc.TransactionNotificationResponse, 16)
+	n.mu.Lock()
+	n.subscribers[ch] = struct{}{}
+	n.mu.Unlock()
+	return ch
+}
+
+func (n *TransactionNotifier) unsubscribe(ch chan *walletrpc.TransactionNotificationResponse) {
+	n.mu.Lock()
+	delete(n.subscribers, ch)
+	n.mu.Unlock()
+}
+
+// transactionNotificationsServer implements
+// walletrpc.TransactionNotificationsServiceServer.
+type transactionNotificationsServer struct {
+	notifier *TransactionNotifier
+}
+
+// NewTransactionNotificationsServer returns a
+// walletrpc.TransactionNotificationsServiceServer that streams events
+// from notifier to each subscribed client.
+func NewTransactionNotificationsServer(notifier *TransactionNotifier) walletrpc.TransactionNotificationsServiceServer {
+	return &transactionNotificationsServer{notifier: notifier}
+}
+
+func (s *transactionNotificationsServer) TransactionNotifications(req *walletrpc.TransactionNotificationsRequest, stream walletrpc.TransactionNotificationsService_TransactionNotificationsServer) error {
+	ch := s.notifier.subscribe()
+	defer s.notifier.unsubscribe(ch)
+
+	for {
+		select {
+		case notification := <-ch:
+			if err := stream.Send(notification); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
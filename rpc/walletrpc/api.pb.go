@@ -0,0 +1,530 @@
+// Package walletrpc is a hand-written implementation of the WalletService
+// and TransactionNotificationsService gRPC services described by
+// api.proto. It is NOT protoc-gen-go output: there is no protoc toolchain
+// in this build environment to generate it from, so the message types,
+// ServiceDesc tables, and client/server plumbing below are maintained by
+// hand to stay wire-compatible with grpc-go's default codec. Keep it in
+// sync with api.proto by hand, and replace it with real generated code
+// the day protoc is available to this build.
+package walletrpc
+
+import (
+	context "context"
+	fmt "fmt"
+
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+)
+
+type SignMessageRequest struct {
+	Passphrase []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Message    string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
+func (m *SignMessageRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*SignMessageRequest) ProtoMessage()    {}
+
+type SignMessageResponse struct {
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
+func (m *SignMessageResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*SignMessageResponse) ProtoMessage()    {}
+
+type VerifyMessageRequest struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
+func (m *VerifyMessageRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*VerifyMessageRequest) ProtoMessage()    {}
+
+type VerifyMessageResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
+func (m *VerifyMessageResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*VerifyMessageResponse) ProtoMessage()    {}
+
+type AccountsRequest struct{}
+
+func (m *AccountsRequest) Reset()         { *m = AccountsRequest{} }
+func (m *AccountsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*AccountsRequest) ProtoMessage()    {}
+
+type AccountsResponse struct {
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *AccountsResponse) Reset()         { *m = AccountsResponse{} }
+func (m *AccountsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*AccountsResponse) ProtoMessage()    {}
+
+type Account struct {
+	AccountNumber uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountName   string `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	TotalBalance  int64  `protobuf:"varint,3,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return fmt.Sprintf("%+v", *m) }
+func (*Account) ProtoMessage()    {}
+
+type BalanceRequest struct {
+	AccountNumber         uint32 `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	RequiredConfirmations int32  `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+}
+
+func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*BalanceRequest) ProtoMessage()    {}
+
+type BalanceResponse struct {
+	Total          int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Spendable      int64 `protobuf:"varint,2,opt,name=spendable,proto3" json:"spendable,omitempty"`
+	ImmatureReward int64 `protobuf:"varint,3,opt,name=immature_reward,json=immatureReward,proto3" json:"immature_reward,omitempty"`
+}
+
+func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*BalanceResponse) ProtoMessage()    {}
+
+type TransactionDestination struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SendMax bool   `protobuf:"varint,3,opt,name=send_max,json=sendMax,proto3" json:"send_max,omitempty"`
+}
+
+func (m *TransactionDestination) Reset()         { *m = TransactionDestination{} }
+func (m *TransactionDestination) String() string { return fmt.Sprintf("%+v", *m) }
+func (*TransactionDestination) ProtoMessage()    {}
+
+type ConstructTransactionRequest struct {
+	SourceAccount         uint32                    `protobuf:"varint,1,opt,name=source_account,json=sourceAccount,proto3" json:"source_account,omitempty"`
+	RequiredConfirmations int32                     `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	Destinations          []*TransactionDestination `protobuf:"bytes,3,rep,name=destinations,proto3" json:"destinations,omitempty"`
+}
+
+func (m *ConstructTransactionRequest) Reset()         { *m = ConstructTransactionRequest{} }
+func (m *ConstructTransactionRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*ConstructTransactionRequest) ProtoMessage()    {}
+
+type ConstructTransactionResponse struct {
+	UnsignedTransaction       []byte `protobuf:"bytes,1,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
+	TotalOutputAmount         int64  `protobuf:"varint,2,opt,name=total_output_amount,json=totalOutputAmount,proto3" json:"total_output_amount,omitempty"`
+	TotalPreviousOutputAmount int64  `protobuf:"varint,3,opt,name=total_previous_output_amount,json=totalPreviousOutputAmount,proto3" json:"total_previous_output_amount,omitempty"`
+	EstimatedSignedSize       int64  `protobuf:"varint,4,opt,name=estimated_signed_size,json=estimatedSignedSize,proto3" json:"estimated_signed_size,omitempty"`
+}
+
+func (m *ConstructTransactionResponse) Reset()         { *m = ConstructTransactionResponse{} }
+func (m *ConstructTransactionResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*ConstructTransactionResponse) ProtoMessage()    {}
+
+type SignTransactionRequest struct {
+	Passphrase          []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	UnsignedTransaction []byte `protobuf:"bytes,2,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
+}
+
+func (m *SignTransactionRequest) Reset()         { *m = SignTransactionRequest{} }
+func (m *SignTransactionRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*SignTransactionRequest) ProtoMessage()    {}
+
+type SignTransactionResponse struct {
+	Transaction []byte `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (m *SignTransactionResponse) Reset()         { *m = SignTransactionResponse{} }
+func (m *SignTransactionResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*SignTransactionResponse) ProtoMessage()    {}
+
+type PublishTransactionRequest struct {
+	SignedTransaction []byte `protobuf:"bytes,1,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+}
+
+func (m *PublishTransactionRequest) Reset()         { *m = PublishTransactionRequest{} }
+func (m *PublishTransactionRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*PublishTransactionRequest) ProtoMessage()    {}
+
+type PublishTransactionResponse struct {
+	TransactionHash []byte `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+}
+
+func (m *PublishTransactionResponse) Reset()         { *m = PublishTransactionResponse{} }
+func (m *PublishTransactionResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*PublishTransactionResponse) ProtoMessage()    {}
+
+type CallJSONRPCRequest struct {
+	Method   string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Args     string `protobuf:"bytes,2,opt,name=args,proto3" json:"args,omitempty"`
+	Address  string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	CaCert   string `protobuf:"bytes,6,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
+}
+
+func (m *CallJSONRPCRequest) Reset()         { *m = CallJSONRPCRequest{} }
+func (m *CallJSONRPCRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*CallJSONRPCRequest) ProtoMessage()    {}
+
+type CallJSONRPCResponse struct {
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *CallJSONRPCResponse) Reset()         { *m = CallJSONRPCResponse{} }
+func (m *CallJSONRPCResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*CallJSONRPCResponse) ProtoMessage()    {}
+
+type TransactionNotificationsRequest struct{}
+
+func (m *TransactionNotificationsRequest) Reset()         { *m = TransactionNotificationsRequest{} }
+func (m *TransactionNotificationsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*TransactionNotificationsRequest) ProtoMessage()    {}
+
+type TransactionNotificationResponse struct {
+	TransactionHash string `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Direction       int32  `protobuf:"varint,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	BlockHeight     int32  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *TransactionNotificationResponse) Reset()         { *m = TransactionNotificationResponse{} }
+func (m *TransactionNotificationResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*TransactionNotificationResponse) ProtoMessage()    {}
+
+var _ proto.Message = (*SignMessageRequest)(nil)
+
+// WalletServiceClient is the client API for WalletService.
+type WalletServiceClient interface {
+	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
+	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	ConstructTransaction(ctx context.Context, in *ConstructTransactionRequest, opts ...grpc.CallOption) (*ConstructTransactionResponse, error)
+	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
+	PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error)
+	CallJSONRPC(ctx context.Context, in *CallJSONRPCRequest, opts ...grpc.CallOption) (*CallJSONRPCResponse, error)
+}
+
+type walletServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewWalletServiceClient returns a WalletServiceClient backed by cc.
+func NewWalletServiceClient(cc *grpc.ClientConn) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
+	out := new(SignMessageResponse)
+	if err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SignMessage", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
+	out := new(VerifyMessageResponse)
+	if err := c.cc.Invoke(ctx, "/walletrpc.WalletService/VerifyMessage", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	if err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Accounts", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	out := new(BalanceResponse)
+	if err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Balance", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ConstructTransaction(ctx context.Context, in *ConstructTransactionRequest, opts ...grpc.CallOption) (*ConstructTransactionResponse, error) {
+	out := new(ConstructTransactionResponse)
+	if err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ConstructTransaction", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
+	out := new(SignTransactionResponse)
+	if err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SignTransaction", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error) {
+	out := new(PublishTransactionResponse)
+	if err := c.cc.Invoke(ctx, "/walletrpc.WalletService/PublishTransaction", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CallJSONRPC(ctx context.Context, in *CallJSONRPCRequest, opts ...grpc.CallOption) (*CallJSONRPCResponse, error) {
+	out := new(CallJSONRPCResponse)
+	if err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CallJSONRPC", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService.
+type WalletServiceServer interface {
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
+	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
+	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	ConstructTransaction(context.Context, *ConstructTransactionRequest) (*ConstructTransactionResponse, error)
+	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
+	PublishTransaction(context.Context, *PublishTransactionRequest) (*PublishTransactionResponse, error)
+	CallJSONRPC(context.Context, *CallJSONRPCRequest) (*CallJSONRPCResponse, error)
+}
+
+func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
+	s.RegisterService(&_WalletService_serviceDesc, srv)
+}
+
+func _WalletService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/walletrpc.WalletService/SignMessage"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignMessage(ctx, req.(*SignMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/walletrpc.WalletService/VerifyMessage"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/walletrpc.WalletService/Accounts"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Accounts(ctx, req.(*AccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/walletrpc.WalletService/Balance"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Balance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ConstructTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ConstructTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/walletrpc.WalletService/ConstructTransaction"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ConstructTransaction(ctx, req.(*ConstructTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/walletrpc.WalletService/SignTransaction"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/walletrpc.WalletService/PublishTransaction"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CallJSONRPC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallJSONRPCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CallJSONRPC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/walletrpc.WalletService/CallJSONRPC"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CallJSONRPC(ctx, req.(*CallJSONRPCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WalletService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "walletrpc.WalletService",
+	HandlerType: (*WalletServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "SignMessage", Handler: _WalletService_SignMessage_Handler},
+		{MethodName: "VerifyMessage", Handler: _WalletService_VerifyMessage_Handler},
+		{MethodName: "Accounts", Handler: _WalletService_Accounts_Handler},
+		{MethodName: "Balance", Handler: _WalletService_Balance_Handler},
+		{MethodName: "ConstructTransaction", Handler: _WalletService_ConstructTransaction_Handler},
+		{MethodName: "SignTransaction", Handler: _WalletService_SignTransaction_Handler},
+		{MethodName: "PublishTransaction", Handler: _WalletService_PublishTransaction_Handler},
+		{MethodName: "CallJSONRPC", Handler: _WalletService_CallJSONRPC_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
+
+// TransactionNotificationsServiceClient is the client API for
+// TransactionNotificationsService.
+type TransactionNotificationsServiceClient interface {
+	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (TransactionNotificationsService_TransactionNotificationsClient, error)
+}
+
+type transactionNotificationsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewTransactionNotificationsServiceClient returns a
+// TransactionNotificationsServiceClient backed by cc.
+func NewTransactionNotificationsServiceClient(cc *grpc.ClientConn) TransactionNotificationsServiceClient {
+	return &transactionNotificationsServiceClient{cc}
+}
+
+func (c *transactionNotificationsServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (TransactionNotificationsService_TransactionNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TransactionNotificationsService_serviceDesc.Streams[0], "/walletrpc.TransactionNotificationsService/TransactionNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactionNotificationsServiceTransactionNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransactionNotificationsService_TransactionNotificationsClient interface {
+	Recv() (*TransactionNotificationResponse, error)
+	grpc.ClientStream
+}
+
+type transactionNotificationsServiceTransactionNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactionNotificationsServiceTransactionNotificationsClient) Recv() (*TransactionNotificationResponse, error) {
+	m := new(TransactionNotificationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TransactionNotificationsServiceServer is the server API for
+// TransactionNotificationsService.
+type TransactionNotificationsServiceServer interface {
+	TransactionNotifications(*TransactionNotificationsRequest, TransactionNotificationsService_TransactionNotificationsServer) error
+}
+
+type TransactionNotificationsService_TransactionNotificationsServer interface {
+	Send(*TransactionNotificationResponse) error
+	grpc.ServerStream
+}
+
+type transactionNotificationsServiceTransactionNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactionNotificationsServiceTransactionNotificationsServer) Send(m *TransactionNotificationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TransactionNotificationsService_TransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionNotificationsServiceServer).TransactionNotifications(m, &transactionNotificationsServiceTransactionNotificationsServer{stream})
+}
+
+func RegisterTransactionNotificationsServiceServer(s *grpc.Server, srv TransactionNotificationsServiceServer) {
+	s.RegisterService(&_TransactionNotificationsService_serviceDesc, srv)
+}
+
+var _TransactionNotificationsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "walletrpc.TransactionNotificationsService",
+	HandlerType: (*TransactionNotificationsServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TransactionNotifications",
+			Handler:       _TransactionNotificationsService_TransactionNotifications_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
@@ -0,0 +1,232 @@
+package dcrlibwallet
+
+import (
+	"time"
+
+	"github.com/asdine/storm"
+	"github.com/decred/dcrd/chaincfg"
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+)
+
+// Ticket is the storm-indexed record of a purchased ticket, kept
+// alongside Transaction so ticket history survives across rescans.
+type Ticket struct {
+	Hash        string                `storm:"id"`
+	Status      txhelper.TicketStatus `storm:"index"`
+	BlockHeight int32
+	Reward      int64
+}
+
+// TicketSummary is the public view of a Ticket returned by ListTickets.
+type TicketSummary struct {
+	Hash        string
+	Status      txhelper.TicketStatus
+	BlockHeight int32
+	Reward      int64
+}
+
+// StakingInfo reports how many of the wallet's tickets are in each stage
+// of their lifecycle.
+type StakingInfo struct {
+	Unmined  int
+	Immature int
+	Live     int
+	Voted    int
+	Missed   int
+	Expired  int
+	Revoked  int
+}
+
+// AgendaChoice is a single consensus-vote agenda and the wallet's current
+// choice for it, as returned by GetVoteChoices.
+type AgendaChoice struct {
+	AgendaID          string
+	AgendaDescription string
+	ChoiceID          string
+	AvailableChoices  []string
+}
+
+// PurchaseTickets purchases numTickets tickets from account, optionally
+// joining poolAddress/poolFees for pooled staking, and returns the hash of
+// every purchased ticket. ticketPrice is not passed to the wallet: tickets
+// are bought at whatever price the wallet's purchase request uses.
+func (lw *LibWallet) PurchaseTickets(passphrase []byte, account uint32, numTickets int, ticketPrice int64, expiry int32, poolAddress string, poolFees float64) ([]string, error) {
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{}
+	}()
+	if err := lw.wallet.Unlock(passphrase, lock); err != nil {
+		return nil, translateError(err)
+	}
+
+	request := &wallet.PurchaseTicketsRequest{
+		Account:     account,
+		Count:       numTickets,
+		Expiry:      expiry,
+		PoolAddress: poolAddress,
+		PoolFees:    poolFees,
+	}
+	response, err := lw.wallet.PurchaseTickets(request)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	ticketHashes := make([]string, len(response.TicketHashes))
+	for i, hash := range response.TicketHashes {
+		ticketHashes[i] = hash.String()
+
+		err := lw.txDB.Save(&Ticket{
+			Hash:   ticketHashes[i],
+			Status: txhelper.TicketStatusUnmined,
+		})
+		if err != nil {
+			log.Errorf("Failed to save ticket %s to tx index: %v", ticketHashes[i], err)
+		}
+	}
+
+	return ticketHashes, nil
+}
+
+// ListTickets returns up to count tickets with the given status, starting
+// after the from'th matching ticket. Ticket records no purchase order, so
+// matching tickets come back in the order storm's Status index holds them.
+func (lw *LibWallet) ListTickets(from, count int32, status txhelper.TicketStatus) ([]*TicketSummary, error) {
+	var tickets []Ticket
+	err := lw.txDB.Find("Status", status, &tickets, storm.Skip(int(from)), storm.Limit(int(count)))
+	if err != nil && err != storm.ErrNotFound {
+		return nil, translateError(err)
+	}
+
+	summaries := make([]*TicketSummary, len(tickets))
+	for i, ticket := range tickets {
+		summaries[i] = &TicketSummary{
+			Hash:        ticket.Hash,
+			Status:      ticket.Status,
+			BlockHeight: ticket.BlockHeight,
+			Reward:      ticket.Reward,
+		}
+	}
+
+	return summaries, nil
+}
+
+// RevokeTickets revokes every missed or expired ticket owned by the
+// wallet, returning them to the wallet's spendable balance.
+func (lw *LibWallet) RevokeTickets(passphrase []byte) error {
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{}
+	}()
+	if err := lw.wallet.Unlock(passphrase, lock); err != nil {
+		return translateError(err)
+	}
+
+	return translateError(lw.wallet.RevokeTickets())
+}
+
+// SetVoteChoices sets the wallet's consensus vote choice for each agenda
+// id in choices, used by every ticket this wallet votes with.
+func (lw *LibWallet) SetVoteChoices(choices map[string]string) error {
+	for agendaID, choiceID := range choices {
+		if _, err := lw.wallet.SetAgendaChoices(wallet.AgendaChoice{
+			AgendaID: agendaID,
+			ChoiceID: choiceID,
+		}); err != nil {
+			return translateError(err)
+		}
+	}
+	return nil
+}
+
+// GetVoteChoices returns every known agenda, the wallet's current choice
+// for it, and the agenda's description and available choices as defined
+// by the network's consensus deployments.
+func (lw *LibWallet) GetVoteChoices() ([]*AgendaChoice, error) {
+	choices, _, err := lw.wallet.AgendaChoices()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	deployments := agendaDeployments(lw.activeNet.Params)
+
+	agendaChoices := make([]*AgendaChoice, len(choices))
+	for i, choice := range choices {
+		agendaChoice := &AgendaChoice{
+			AgendaID: choice.AgendaID,
+			ChoiceID: choice.ChoiceID,
+		}
+
+		if deployment, ok := deployments[choice.AgendaID]; ok {
+			agendaChoice.AgendaDescription = deployment.Vote.Description
+			agendaChoice.AvailableChoices = make([]string, len(deployment.Vote.Choices))
+			for j, voteChoice := range deployment.Vote.Choices {
+				agendaChoice.AvailableChoices[j] = voteChoice.Id
+			}
+		}
+
+		agendaChoices[i] = agendaChoice
+	}
+
+	return agendaChoices, nil
+}
+
+// agendaDeployments flattens every consensus vote deployment across all
+// vote versions defined for params into a lookup by agenda ID.
+func agendaDeployments(params *chaincfg.Params) map[string]chaincfg.ConsensusDeployment {
+	deployments := make(map[string]chaincfg.ConsensusDeployment)
+	for _, versionDeployments := range params.Deployments {
+		for _, deployment := range versionDeployments {
+			deployments[deployment.Vote.Id] = deployment
+		}
+	}
+	return deployments
+}
+
+// StakeInfo returns a count of this wallet's tickets in each stage of
+// their lifecycle.
+func (lw *LibWallet) StakeInfo() (*StakingInfo, error) {
+	var tickets []Ticket
+	if err := lw.txDB.All(&tickets); err != nil && err != storm.ErrNotFound {
+		return nil, translateError(err)
+	}
+
+	info := &StakingInfo{}
+	for _, ticket := range tickets {
+		switch ticket.Status {
+		case txhelper.TicketStatusUnmined:
+			info.Unmined++
+		case txhelper.TicketStatusImmature:
+			info.Immature++
+		case txhelper.TicketStatusLive:
+			info.Live++
+		case txhelper.TicketStatusVoted:
+			info.Voted++
+		case txhelper.TicketStatusMissed:
+			info.Missed++
+		case txhelper.TicketStatusExpired:
+			info.Expired++
+		case txhelper.TicketStatusRevoked:
+			info.Revoked++
+		}
+	}
+
+	return info, nil
+}
+
+// updateTicketStatus is called by the sync listener as each new block
+// connects, so a ticket's status in the tx index tracks its true state in
+// the wallet (e.g. Immature -> Live, Live -> Voted) without requiring a
+// full rescan.
+func (lw *LibWallet) updateTicketStatus(hash string, status txhelper.TicketStatus, blockHeight int32, reward int64) error {
+	var ticket Ticket
+	if err := lw.txDB.One("Hash", hash, &ticket); err != nil {
+		return translateError(err)
+	}
+
+	ticket.Status = status
+	ticket.BlockHeight = blockHeight
+	ticket.Reward = reward
+
+	return translateError(lw.txDB.Save(&ticket))
+}
@@ -0,0 +1,170 @@
+package dcrlibwallet
+
+import (
+	"bytes"
+
+	"github.com/asdine/storm"
+	"github.com/decred/dcrd/blockchain/stake"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/raedahgroup/dcrlibwallet/rpc/walletrpc"
+	"github.com/raedahgroup/dcrlibwallet/txhelper"
+)
+
+// listenForTransactions subscribes to the wallet's transaction
+// notifications and keeps the storm tx index, ticket statuses, and any
+// gRPC subscribers in sync with it. It is started from walletLoader's
+// RunAfterLoad callback and runs for the lifetime of the loaded wallet.
+func (lw *LibWallet) listenForTransactions() {
+	n := lw.wallet.NtfnServer.TransactionNotifications()
+	defer n.Done()
+
+	for {
+		select {
+		case v, ok := <-n.C:
+			if !ok {
+				return
+			}
+			lw.handleTransactionNotification(v)
+		case <-shutdownSignaled:
+			return
+		}
+	}
+}
+
+// handleTransactionNotification reacts to a single batch of wallet
+// transaction notifications, handling any disconnected blocks (a reorg)
+// before anything else so the tx index isn't updated from a chain view
+// that's about to be rolled back.
+func (lw *LibWallet) handleTransactionNotification(notification *wallet.TransactionNotifications) {
+	if len(notification.DetachedBlocks) > 0 {
+		lw.handleDetachedBlocks(notification.DetachedBlocks)
+	}
+
+	for _, block := range notification.AttachedBlocks {
+		for _, tx := range block.Transactions {
+			lw.notifyTransaction(tx, block.Height)
+			lw.updateTicketStatusFromTx(tx, block.Height)
+		}
+
+		if err := lw.setEndBlock(block.Hash.String(), block.Height); err != nil {
+			log.Errorf("Failed to index attached block %s at height %d: %v", block.Hash, block.Height, err)
+		}
+
+		lw.updateMaturingTickets(block.Height)
+	}
+	for _, tx := range notification.UnminedTransactions {
+		lw.notifyTransaction(tx, -1)
+	}
+}
+
+// updateTicketStatusFromTx inspects a mined transaction and, if it is a
+// ticket purchase, vote, or revocation for one of this wallet's tracked
+// tickets, updates that ticket's status in the tx index.
+func (lw *LibWallet) updateTicketStatusFromTx(tx wallet.TransactionSummary, blockHeight int32) {
+	var msgTx wire.MsgTx
+	if err := msgTx.Deserialize(bytes.NewReader(tx.Transaction)); err != nil {
+		return
+	}
+
+	switch {
+	case stake.IsSStx(&msgTx):
+		if err := lw.updateTicketStatus(tx.Hash.String(), txhelper.TicketStatusImmature, blockHeight, 0); err != nil {
+			log.Errorf("Failed to mark ticket %s immature: %v", tx.Hash, err)
+		}
+
+	case stake.IsSSGen(&msgTx):
+		ticketHash := msgTx.TxIn[1].PreviousOutPoint.Hash.String()
+		var reward int64
+		for _, out := range msgTx.TxOut {
+			reward += out.Value
+		}
+		if err := lw.updateTicketStatus(ticketHash, txhelper.TicketStatusVoted, blockHeight, reward); err != nil {
+			log.Errorf("Failed to mark ticket %s voted: %v", ticketHash, err)
+		}
+
+	case stake.IsSSRtx(&msgTx):
+		ticketHash := msgTx.TxIn[0].PreviousOutPoint.Hash.String()
+		var reward int64
+		for _, out := range msgTx.TxOut {
+			reward += out.Value
+		}
+		if err := lw.updateTicketStatus(ticketHash, txhelper.TicketStatusRevoked, blockHeight, reward); err != nil {
+			log.Errorf("Failed to mark ticket %s revoked: %v", ticketHash, err)
+		}
+	}
+}
+
+// updateMaturingTickets advances every tracked ticket's status purely
+// based on its age as of the newly connected block at height: Immature
+// tickets that have reached TicketMaturity become Live, and Live tickets
+// that have run out the ticket pool without voting or being revoked
+// become Expired. Transitioning a ticket to Missed requires the stake
+// daemon's live-ticket-selection notifications, which this tx-only
+// listener does not have access to, so it is left to RevokeTickets'
+// existing handling of the revocation path.
+func (lw *LibWallet) updateMaturingTickets(height int32) {
+	var tickets []Ticket
+	if err := lw.txDB.All(&tickets); err != nil && err != storm.ErrNotFound {
+		log.Errorf("Failed to read tickets for maturity check: %v", err)
+		return
+	}
+
+	maturity := int32(lw.activeNet.Params.TicketMaturity)
+	expiry := int32(lw.activeNet.Params.TicketExpiry)
+
+	for _, ticket := range tickets {
+		age := height - ticket.BlockHeight
+		switch ticket.Status {
+		case txhelper.TicketStatusImmature:
+			if age >= maturity {
+				if err := lw.updateTicketStatus(ticket.Hash, txhelper.TicketStatusLive, height, ticket.Reward); err != nil {
+					log.Errorf("Failed to mark ticket %s live: %v", ticket.Hash, err)
+				}
+			}
+		case txhelper.TicketStatusLive:
+			if age >= expiry {
+				if err := lw.updateTicketStatus(ticket.Hash, txhelper.TicketStatusExpired, height, ticket.Reward); err != nil {
+					log.Errorf("Failed to mark ticket %s expired: %v", ticket.Hash, err)
+				}
+			}
+		}
+	}
+}
+
+// notifyTransaction forwards tx to every subscriber of the gRPC
+// TransactionNotificationsService, the same events the wallet's own
+// transaction notifications carry.
+func (lw *LibWallet) notifyTransaction(tx wallet.TransactionSummary, blockHeight int32) {
+	if lw.txNotifier == nil {
+		return
+	}
+
+	direction := txhelper.TransactionDirectionUnclear
+	switch {
+	case len(tx.MyInputs) > 0:
+		direction = txhelper.TransactionDirectionSent
+	case len(tx.MyOutputs) > 0:
+		direction = txhelper.TransactionDirectionReceived
+	}
+
+	lw.txNotifier.Notify(&walletrpc.TransactionNotificationResponse{
+		TransactionHash: tx.Hash.String(),
+		Direction:       int32(direction),
+		BlockHeight:     blockHeight,
+	})
+}
+
+// handleDetachedBlocks is called when the wallet reports one or more
+// blocks disconnected from the best chain (a reorganization), and rewinds
+// the tx index to the new fork point.
+func (lw *LibWallet) handleDetachedBlocks(detached []*chainhash.Hash) {
+	oldTipHash := detached[0].String()
+
+	newTipHash, newTipHeight := lw.wallet.MainChainTip()
+
+	if err := lw.onReorg(oldTipHash, newTipHash.String(), newTipHeight); err != nil {
+		log.Errorf("Failed to handle reorg from %s to %s: %v", oldTipHash, newTipHash, err)
+	}
+}
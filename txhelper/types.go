@@ -28,6 +28,43 @@ func (direction TransactionDirection) String() string {
 	}
 }
 
+var (
+	ticketStatusNames = []string{"Unmined", "Immature", "Live", "Voted", "Missed", "Expired", "Revoked"}
+)
+
+const (
+	// TicketStatusUnmined for tickets broadcast but not yet mined
+	TicketStatusUnmined TicketStatus = iota
+
+	// TicketStatusImmature for mined tickets not yet old enough to vote
+	TicketStatusImmature
+
+	// TicketStatusLive for tickets in the live ticket pool, eligible to vote
+	TicketStatusLive
+
+	// TicketStatusVoted for tickets that have voted
+	TicketStatusVoted
+
+	// TicketStatusMissed for live tickets that were selected to vote but did not vote
+	TicketStatusMissed
+
+	// TicketStatusExpired for live tickets that reached the end of the ticket pool without voting
+	TicketStatusExpired
+
+	// TicketStatusRevoked for missed or expired tickets that were revoked
+	TicketStatusRevoked
+)
+
+// TicketStatus describes the lifecycle stage of a purchased ticket.
+type TicketStatus int8
+
+func (status TicketStatus) String() string {
+	if status <= TicketStatusRevoked {
+		return ticketStatusNames[status]
+	}
+	return "Unknown"
+}
+
 type TransactionDestination struct {
 	Address string
 	Amount  float64
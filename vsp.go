@@ -0,0 +1,242 @@
+package dcrlibwallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/asdine/storm"
+	"github.com/decred/dcrd/blockchain/stake"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil"
+	"github.com/decred/dcrd/txscript"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors"
+	"github.com/decred/dcrwallet/wallet"
+	"github.com/raedahgroup/dcrlibwallet/addresshelper"
+	"github.com/raedahgroup/dcrlibwallet/utils"
+	"github.com/raedahgroup/dcrlibwallet/vsp"
+)
+
+// ErrVSPNotSet is returned by VSP-backed staking methods when SetVSP has
+// not been called yet.
+const ErrVSPNotSet = "vsp_not_set"
+
+// VSPConfig is the per-wallet Voting Service Provider configuration,
+// persisted to the tx index database so a previously chosen VSP's pinned
+// pubkey does not need to be re-fetched on every startup.
+type VSPConfig struct {
+	ID     int    `storm:"id,increment"`
+	URL    string `storm:"unique"`
+	PubKey []byte
+}
+
+// SetVSP points the wallet at a Voting Service Provider, fetching and
+// pinning its ed25519 pubkey on first use. Subsequent calls with the same
+// url reuse the previously pinned pubkey instead of trusting the network
+// again. Tickets purchased after this call are handed off to the VSP for
+// voting via PurchaseTicketsWithVSP.
+func (lw *LibWallet) SetVSP(url string) error {
+	var config VSPConfig
+	err := lw.txDB.One("URL", url, &config)
+	if err != nil && err != storm.ErrNotFound {
+		return translateError(err)
+	}
+
+	client := vsp.NewWithPinnedKey(url, config.PubKey)
+	info, err := client.FetchInfo()
+	if err != nil {
+		return err
+	}
+
+	if config.PubKey == nil {
+		config.URL = url
+		config.PubKey = info.PubKey
+		if err := lw.txDB.Save(&config); err != nil {
+			return translateError(err)
+		}
+	}
+
+	lw.vspClient = client
+	// The VSP votes on the wallet's behalf, so the wallet no longer needs
+	// to run its own always-on voting; the local voting key is still kept
+	// so it can be handed to the VSP at payfee time.
+	lw.stakeOptions.VotingEnabled = false
+
+	return nil
+}
+
+// PurchaseTicketsWithVSP purchases numTickets tickets and registers each
+// one with the configured VSP: the VSP's fee is paid from the wallet and
+// the ticket's local voting key is handed over via payfee, so the VSP
+// votes the ticket instead of this wallet.
+func (lw *LibWallet) PurchaseTicketsWithVSP(passphrase []byte, numTickets int, expiry int32) ([]string, error) {
+	if lw.vspClient == nil {
+		return nil, errors.New(ErrVSPNotSet)
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{}
+	}()
+	if err := lw.wallet.Unlock(passphrase, lock); err != nil {
+		return nil, translateError(err)
+	}
+
+	purchaseRequest := &wallet.PurchaseTicketsRequest{
+		Count:  numTickets,
+		Expiry: expiry,
+	}
+	response, err := lw.wallet.PurchaseTickets(purchaseRequest)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	ticketHashes := make([]string, len(response.TicketHashes))
+	for i, hash := range response.TicketHashes {
+		ticketHashes[i] = hash.String()
+	}
+
+	for _, ticketHash := range ticketHashes {
+		if err := lw.registerTicketWithVSP(ticketHash); err != nil {
+			log.Errorf("Failed to register ticket %s with VSP: %v", ticketHash, err)
+			return ticketHashes, err
+		}
+	}
+
+	return ticketHashes, nil
+}
+
+// registerTicketWithVSP runs the feeaddress/payfee handshake described by
+// the VSPD API for a single already-purchased, already-unlocked ticket.
+func (lw *LibWallet) registerTicketWithVSP(ticketHash string) error {
+	hash, err := chainhash.NewHashFromStr(ticketHash)
+	if err != nil {
+		return translateError(err)
+	}
+
+	commitmentAddress, err := lw.ticketCommitmentAddress(hash)
+	if err != nil {
+		return err
+	}
+
+	sign := func(message string) (string, error) {
+		sig, err := lw.wallet.SignMessage(message, commitmentAddress)
+		if err != nil {
+			return "", translateError(err)
+		}
+		return utils.EncodeBase64(sig), nil
+	}
+
+	feeAddress, err := lw.vspClient.GetFeeAddress(ticketHash, sign)
+	if err != nil {
+		return err
+	}
+
+	feeTxHex, err := lw.payFeeTx(feeAddress.FeeAddress, feeAddress.FeeAmount)
+	if err != nil {
+		return translateError(err)
+	}
+
+	votingAddress, err := lw.ticketVotingAddress(hash)
+	if err != nil {
+		return err
+	}
+
+	votingKeyWIF, err := lw.wallet.DumpWIFPrivateKey(votingAddress)
+	if err != nil {
+		return translateError(err)
+	}
+
+	_, err = lw.vspClient.PayFee(ticketHash, feeTxHex, votingKeyWIF, sign)
+	return err
+}
+
+// payFeeTx decodes feeAddress the same way ConstructTransaction decodes
+// its destinations, sends feeAmount to it, and returns the serialized,
+// signed fee transaction as hex, the form VSPD's payfee endpoint expects.
+func (lw *LibWallet) payFeeTx(feeAddress string, feeAmount int64) (string, error) {
+	addr, err := addresshelper.DecodeForNetwork(feeAddress, lw.activeNet.Params)
+	if err != nil {
+		return "", translateError(err)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return "", translateError(err)
+	}
+
+	feeTx, err := lw.wallet.SendOutputs([]*wire.TxOut{{Value: feeAmount, PkScript: pkScript}}, wallet.DefaultAccountNum, 1)
+	if err != nil {
+		return "", translateError(err)
+	}
+
+	var buf bytes.Buffer
+	if err := feeTx.Serialize(&buf); err != nil {
+		return "", translateError(err)
+	}
+
+	return hex.EncodeToString(buf.Bytes()), nil
+}
+
+// ticketCommitmentAddress extracts the commitment address (output 1) from
+// a purchased ticket, the address whose private key must sign all VSPD
+// requests for that ticket.
+func (lw *LibWallet) ticketCommitmentAddress(ticketHash *chainhash.Hash) (dcrutil.Address, error) {
+	ticket, err := lw.wallet.TxDetails(ticketHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return stake.AddrFromSStxPkScrCommitment(ticket.MsgTx.TxOut[1].PkScript, lw.activeNet.Params)
+}
+
+// ticketVotingAddress extracts the voting rights address (output 0) from a
+// purchased ticket, the address whose private key the VSP needs handed
+// over at payfee time in order to vote the ticket on the wallet's behalf.
+func (lw *LibWallet) ticketVotingAddress(ticketHash *chainhash.Hash) (dcrutil.Address, error) {
+	ticket, err := lw.wallet.TxDetails(ticketHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(ticket.MsgTx.TxOut[0].Version, ticket.MsgTx.TxOut[0].PkScript, lw.activeNet.Params)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no voting address found in ticket %s", ticketHash)
+	}
+
+	return addrs[0], nil
+}
+
+// VSPTicketInfo returns the VSP's current view of ticketHash, including
+// whether its fee has confirmed and whether the VSP has the voting key
+// it needs to vote the ticket.
+func (lw *LibWallet) VSPTicketInfo(ticketHash string) (*vsp.TicketStatus, error) {
+	if lw.vspClient == nil {
+		return nil, errors.New(ErrVSPNotSet)
+	}
+
+	hash, err := chainhash.NewHashFromStr(ticketHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	commitmentAddress, err := lw.ticketCommitmentAddress(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	sign := func(message string) (string, error) {
+		sig, err := lw.wallet.SignMessage(message, commitmentAddress)
+		if err != nil {
+			return "", translateError(err)
+		}
+		return utils.EncodeBase64(sig), nil
+	}
+
+	return lw.vspClient.TicketStatus(ticketHash, sign)
+}
@@ -0,0 +1,198 @@
+// Package vsp implements the client side of the VSPD ticket-buying API
+// (https://github.com/decred/vspd), letting a wallet delegate voting to a
+// Voting Service Provider instead of running its own always-on voting
+// wallet.
+package vsp
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	pathVSPInfo      = "/api/v3/vspinfo"
+	pathFeeAddress   = "/api/v3/feeaddress"
+	pathPayFee       = "/api/v3/payfee"
+	pathTicketStatus = "/api/v3/ticketstatus"
+
+	headerClientSignature = "VSP-Client-Signature"
+	headerServerSignature = "VSP-Server-Signature"
+)
+
+// SignFunc signs message with the private key for a wallet-controlled
+// address (the ticket's commitment address), as LibWallet.SignMessage
+// does, and returns the signature base64-encoded.
+type SignFunc func(message string) (string, error)
+
+// Client talks to a single VSP instance and pins its ed25519 pubkey on
+// first use (trust-on-first-use), verifying every later response against
+// that pinned key.
+type Client struct {
+	URL        string
+	PubKey     []byte
+	httpClient *http.Client
+}
+
+// New returns a Client for url with no pubkey pinned yet; call FetchInfo
+// to pin it.
+func New(url string) *Client {
+	return &Client{
+		URL:        strings.TrimRight(url, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// NewWithPinnedKey returns a Client for url whose responses are verified
+// against an already-pinned pubkey, e.g. one loaded back from the wallet's
+// persisted VSP configuration.
+func NewWithPinnedKey(url string, pubKey []byte) *Client {
+	c := New(url)
+	c.PubKey = pubKey
+	return c
+}
+
+// FetchInfo retrieves /api/v3/vspinfo and, if no pubkey is pinned yet,
+// pins the one returned. If a pubkey is already pinned, the response's
+// pubkey must match it exactly.
+func (c *Client) FetchInfo() (*Info, error) {
+	resp, err := c.httpClient.Get(c.URL + pathVSPInfo)
+	if err != nil {
+		return nil, fmt.Errorf("vsp: fetching vspinfo: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("vsp: reading vspinfo response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vsp: vspinfo returned status %d: %s", resp.StatusCode, body)
+	}
+
+	var info Info
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("vsp: decoding vspinfo response: %v", err)
+	}
+	if len(info.PubKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("vsp: vspinfo returned an invalid pubkey length")
+	}
+
+	if c.PubKey == nil {
+		c.PubKey = info.PubKey
+	} else if !bytes.Equal(c.PubKey, info.PubKey) {
+		return nil, fmt.Errorf("vsp: pinned pubkey mismatch for %s", c.URL)
+	}
+
+	return &info, nil
+}
+
+// GetFeeAddress requests a fee address for ticketHash, signing the
+// request body with sign and verifying the server's response signature
+// against the pinned pubkey.
+func (c *Client) GetFeeAddress(ticketHash string, sign SignFunc) (*FeeAddressResponse, error) {
+	if c.PubKey == nil {
+		return nil, fmt.Errorf("vsp: no pubkey pinned for %s, call FetchInfo first", c.URL)
+	}
+
+	reqBody, err := json.Marshal(&FeeAddressRequest{TicketHash: ticketHash})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp FeeAddressResponse
+	if err := c.signedPost(pathFeeAddress, reqBody, sign, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PayFee submits the signed fee transaction and the wallet's local voting
+// key for ticketHash, handing off voting responsibility to the VSP.
+func (c *Client) PayFee(ticketHash, feeTxHex, votingKey string, sign SignFunc) (*PayFeeResponse, error) {
+	reqBody, err := json.Marshal(&PayFeeRequest{
+		TicketHash: ticketHash,
+		FeeTx:      feeTxHex,
+		VotingKey:  votingKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp PayFeeResponse
+	if err := c.signedPost(pathPayFee, reqBody, sign, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// TicketStatus requests the VSP's current view of ticketHash.
+func (c *Client) TicketStatus(ticketHash string, sign SignFunc) (*TicketStatus, error) {
+	reqBody, err := json.Marshal(&TicketStatusRequest{TicketHash: ticketHash})
+	if err != nil {
+		return nil, err
+	}
+
+	var status TicketStatus
+	if err := c.signedPost(pathTicketStatus, reqBody, sign, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// signedPost signs reqBody, POSTs it to path with the signature in the
+// VSP-Client-Signature header, verifies the server's VSP-Server-Signature
+// header against the pinned pubkey, and decodes the response into out.
+func (c *Client) signedPost(path string, reqBody []byte, sign SignFunc, out interface{}) error {
+	signature, err := sign(string(reqBody))
+	if err != nil {
+		return fmt.Errorf("vsp: signing request: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.URL+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerClientSignature, signature)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("vsp: request to %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("vsp: reading response from %s: %v", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vsp: %s returned status %d: %s", path, resp.StatusCode, body)
+	}
+
+	if err := c.verifyServerSignature(resp.Header.Get(headerServerSignature), body); err != nil {
+		return fmt.Errorf("vsp: %s: %v", path, err)
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+func (c *Client) verifyServerSignature(signatureBase64 string, body []byte) error {
+	if c.PubKey == nil {
+		return fmt.Errorf("no pubkey pinned, call FetchInfo first")
+	}
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return fmt.Errorf("decoding server signature: %v", err)
+	}
+	if !ed25519.Verify(c.PubKey, body, signature) {
+		return fmt.Errorf("server signature verification failed")
+	}
+	return nil
+}
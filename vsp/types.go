@@ -0,0 +1,53 @@
+package vsp
+
+// Info describes the static identity and fee policy of a Voting Service
+// Provider, as returned by GET /api/v3/vspinfo. PubKey is pinned the first
+// time it is fetched for a given VSP URL and is used to verify every
+// subsequent response from that VSP.
+type Info struct {
+	PubKey        []byte  `json:"pubkey"`
+	FeePercentage float64 `json:"feepercentage"`
+	VspClosed     bool    `json:"vspclosed"`
+	Network       string  `json:"network"`
+}
+
+// FeeAddressRequest is the signed body of POST /api/v3/feeaddress.
+type FeeAddressRequest struct {
+	TicketHash string `json:"tickethash"`
+}
+
+// FeeAddressResponse is the VSP's reply to a fee address request.
+type FeeAddressResponse struct {
+	FeeAddress string `json:"feeaddress"`
+	FeeAmount  int64  `json:"feeamount"`
+	Expiration int64  `json:"expiration"`
+	Request    []byte `json:"request"`
+}
+
+// PayFeeRequest is the signed body of POST /api/v3/payfee.
+type PayFeeRequest struct {
+	TicketHash string `json:"tickethash"`
+	FeeTx      string `json:"feetx"`
+	VotingKey  string `json:"votingkey"`
+}
+
+// PayFeeResponse is the VSP's reply once the fee transaction is accepted.
+type PayFeeResponse struct {
+	Request []byte `json:"request"`
+}
+
+// TicketStatusRequest is the signed body of POST /api/v3/ticketstatus.
+type TicketStatusRequest struct {
+	TicketHash string `json:"tickethash"`
+}
+
+// TicketStatus reports the VSP's view of a single ticket that was
+// registered with it via PayFee.
+type TicketStatus struct {
+	TicketHash          string            `json:"tickethash"`
+	TicketConfirmed     bool              `json:"ticketconfirmed"`
+	VoteChoices         map[string]string `json:"votechoices"`
+	VotingKeyRegistered bool              `json:"votingkeyregistered"`
+	FeeTxStatus         string            `json:"feetxstatus"`
+	FeeTxHash           string            `json:"feetxhash"`
+}